@@ -0,0 +1,132 @@
+// Package barcode renders EAN-13 barcodes for valid ISBNs, as printed on the
+// back cover of most books, with support for the 5-digit price add-on
+// commonly printed alongside it.
+package barcode
+
+import (
+	"fmt"
+
+	"github.com/skowalak/isbn"
+)
+
+// Barcode is the EAN-13 encoding of a valid ISBN-13, optionally with a
+// 5-digit EAN-5 add-on.
+type Barcode struct {
+	digits [13]byte
+	addOn  [5]byte
+	hasAdd bool
+}
+
+// Option configures a Barcode constructed by New.
+type Option func(*Barcode) error
+
+// AddOn attaches a 5-digit EAN-5 supplement - commonly the book's price -
+// to the barcode. price must be exactly 5 decimal digits.
+func AddOn(price string) Option {
+	return func(b *Barcode) error {
+		if len(price) != 5 {
+			return fmt.Errorf("barcode: addon: price must be 5 digits, got %q", price)
+		}
+		var digits [5]byte
+		for i := 0; i < 5; i++ {
+			d := price[i]
+			if d < '0' || d > '9' {
+				return fmt.Errorf("barcode: addon: price must be numeric, got %q", price)
+			}
+			digits[i] = d - '0'
+		}
+		b.addOn = digits
+		b.hasAdd = true
+		return nil
+	}
+}
+
+// New parses s as a valid ISBN-13 (see isbn.ISBN13) and builds the EAN-13
+// barcode for it.
+func New(s string, opts ...Option) (*Barcode, error) {
+	full, err := isbn.ISBN13(s)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the first twelve digits are taken from full; the EAN-13 check digit
+	// is computed from them, so an ISBN-10 input does not carry its mod-11
+	// check digit into the symbol.
+	var digits [13]byte
+	sum := 0
+	for i := 0; i < 12; i++ {
+		digits[i] = full[i] - '0'
+		if i%2 == 0 {
+			sum += int(digits[i])
+		} else {
+			sum += 3 * int(digits[i])
+		}
+	}
+	digits[12] = byte((10 - sum%10) % 10)
+	b := &Barcode{digits: digits}
+
+	for _, opt := range opts {
+		if err := opt(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Modules returns the raw EAN-13 module bit pattern for the main symbol: one
+// byte per module, 0 for a white (background) module and 1 for a black
+// (bar) module. It does not include quiet zones. The pattern encodes the
+// first digit implicitly via the L/G parity of the following six digits, as
+// EAN-13 requires.
+func (b *Barcode) Modules() []byte {
+	parity := parityPatterns[b.digits[0]]
+
+	out := make([]byte, 0, 95)
+	out = append(out, startEndGuard...)
+	for i, d := range b.digits[1:7] {
+		if parity[i] == 'L' {
+			out = append(out, lCodes[d]...)
+		} else {
+			out = append(out, gCodes[d]...)
+		}
+	}
+	out = append(out, centerGuard...)
+	for _, d := range b.digits[7:13] {
+		out = append(out, rCodes[d]...)
+	}
+	out = append(out, startEndGuard...)
+	return out
+}
+
+// AddOnModules returns the raw EAN-5 module bit pattern for the price
+// add-on, and whether the barcode has one at all. Unlike the main symbol, an
+// add-on carries no implicit digit: its checksum instead selects the L/G
+// parity used to encode its five digits.
+func (b *Barcode) AddOnModules() ([]byte, bool) {
+	if !b.hasAdd {
+		return nil, false
+	}
+
+	parity := addOnParityPatterns[addOnChecksum(b.addOn)]
+
+	out := make([]byte, 0, 48)
+	out = append(out, addOnGuard...)
+	for i, d := range b.addOn {
+		if i > 0 {
+			out = append(out, addOnSeparator...)
+		}
+		if parity[i] == 'L' {
+			out = append(out, lCodes[d]...)
+		} else {
+			out = append(out, gCodes[d]...)
+		}
+	}
+	return out, true
+}
+
+// addOnChecksum calculates the EAN-5 check value that selects the parity
+// pattern for a 5-digit add-on.
+func addOnChecksum(digits [5]byte) int {
+	sum := 3*(int(digits[0])+int(digits[2])+int(digits[4])) + 9*(int(digits[1])+int(digits[3]))
+	return sum % 10
+}
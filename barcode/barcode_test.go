@@ -0,0 +1,91 @@
+package barcode
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+)
+
+func TestModules(t *testing.T) {
+	b, err := New("9780672323560")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := b.Modules()
+	if len(got) != 95 {
+		t.Fatalf("got %d modules, want 95", len(got))
+	}
+	want := bits("10101110110001001010011101011110010001001001101010100001011011001000010100111010100001110010101")
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	if _, ok := b.AddOnModules(); ok {
+		t.Errorf("got an add-on pattern for a barcode without one")
+	}
+}
+
+func TestAddOnModules(t *testing.T) {
+	b, err := New("9780672323560", AddOn("51999"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, ok := b.AddOnModules()
+	if !ok {
+		t.Fatal("got no add-on pattern for a barcode with one")
+	}
+	if len(got) != 48 {
+		t.Fatalf("got %d modules, want 48", len(got))
+	}
+	want := bits("010110110001010011001010010111010001011010010111")
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestAddOnInvalidPrice(t *testing.T) {
+	if _, err := New("9780672323560", AddOn("1234")); err == nil {
+		t.Error("got no error for a 4-digit price")
+	}
+	if _, err := New("9780672323560", AddOn("abcde")); err == nil {
+		t.Error("got no error for a non-numeric price")
+	}
+}
+
+func TestInvalidISBN(t *testing.T) {
+	if _, err := New("not-an-isbn"); err == nil {
+		t.Error("got no error for an invalid ISBN")
+	}
+}
+
+func TestSVG(t *testing.T) {
+	b, err := New("9780672323560")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	svg := b.SVG()
+	if !bytes.Contains([]byte(svg), []byte("<svg")) {
+		t.Errorf("got %q, want a well-formed SVG document", svg)
+	}
+}
+
+func TestPNG(t *testing.T) {
+	b, err := New("9780672323560")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := b.PNG()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("got invalid PNG data: %v", err)
+	}
+	bounds := img.Bounds()
+	if bounds.Dy() != barHeight {
+		t.Errorf("got height %d, want %d", bounds.Dy(), barHeight)
+	}
+}
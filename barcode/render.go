@@ -0,0 +1,100 @@
+package barcode
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+// moduleWidth, barHeight and quietModules are the rendering defaults used by
+// SVG and PNG: each module is moduleWidth pixels wide, bars are barHeight
+// pixels tall, and quietModules blank modules are left on either side of the
+// symbol, as required for a scanner to reliably find its edges.
+const (
+	moduleWidth  = 2
+	barHeight    = 60
+	quietModules = 10
+	addOnGap     = 9
+)
+
+// SVG renders the barcode (and its AddOn, if any) as a standalone SVG
+// document.
+func (b *Barcode) SVG() string {
+	modules := b.Modules()
+	addOn, hasAddOn := b.AddOnModules()
+
+	width := (quietModules*2 + len(modules)) * moduleWidth
+	if hasAddOn {
+		width += (addOnGap + len(addOn)) * moduleWidth
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, barHeight, width, barHeight)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="white"/>`, width, barHeight)
+
+	x := quietModules * moduleWidth
+	x = writeSVGBars(&buf, modules, x)
+	if hasAddOn {
+		x += addOnGap * moduleWidth
+		writeSVGBars(&buf, addOn, x)
+	}
+
+	buf.WriteString(`</svg>`)
+	return buf.String()
+}
+
+func writeSVGBars(buf *strings.Builder, modules []byte, x int) int {
+	for _, m := range modules {
+		if m == 1 {
+			fmt.Fprintf(buf, `<rect x="%d" y="0" width="%d" height="%d" fill="black"/>`, x, moduleWidth, barHeight)
+		}
+		x += moduleWidth
+	}
+	return x
+}
+
+// PNG renders the barcode (and its AddOn, if any) as a grayscale PNG image.
+func (b *Barcode) PNG() ([]byte, error) {
+	modules := b.Modules()
+	addOn, hasAddOn := b.AddOnModules()
+
+	width := (quietModules*2 + len(modules)) * moduleWidth
+	if hasAddOn {
+		width += (addOnGap + len(addOn)) * moduleWidth
+	}
+
+	img := image.NewGray(image.Rect(0, 0, width, barHeight))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+
+	x := quietModules * moduleWidth
+	x = drawPNGBars(img, modules, x)
+	if hasAddOn {
+		x += addOnGap * moduleWidth
+		drawPNGBars(img, addOn, x)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("barcode: png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func drawPNGBars(img *image.Gray, modules []byte, x int) int {
+	for _, m := range modules {
+		if m == 1 {
+			for dx := 0; dx < moduleWidth; dx++ {
+				for y := 0; y < barHeight; y++ {
+					img.SetGray(x+dx, y, color.Gray{Y: 0})
+				}
+			}
+		}
+		x += moduleWidth
+	}
+	return x
+}
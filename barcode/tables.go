@@ -0,0 +1,59 @@
+package barcode
+
+// bits converts a string of '0' and '1' characters into a module slice.
+func bits(s string) []byte {
+	out := make([]byte, len(s))
+	for i, r := range s {
+		if r == '1' {
+			out[i] = 1
+		}
+	}
+	return out
+}
+
+// startEndGuard and centerGuard are the guard bar patterns shared by every
+// EAN-13 symbol.
+var (
+	startEndGuard = bits("101")
+	centerGuard   = bits("01010")
+)
+
+// addOnGuard and addOnSeparator are the guard and inter-digit separator
+// patterns used by the 5-digit EAN-5 supplement.
+var (
+	addOnGuard     = bits("01011")
+	addOnSeparator = bits("01")
+)
+
+// lCodes and gCodes encode a digit 0-9 for the left-hand half of an EAN-13
+// symbol under odd (L) and even (G) parity respectively. rCodes encodes a
+// digit for the right-hand half; it is the bitwise complement of lCodes.
+var (
+	lCodes = [10][]byte{
+		bits("0001101"), bits("0011001"), bits("0010011"), bits("0111101"), bits("0100011"),
+		bits("0110001"), bits("0101111"), bits("0111011"), bits("0110111"), bits("0001011"),
+	}
+	gCodes = [10][]byte{
+		bits("0100111"), bits("0110011"), bits("0011011"), bits("0100001"), bits("0011101"),
+		bits("0111001"), bits("0000101"), bits("0010001"), bits("0001001"), bits("0010111"),
+	}
+	rCodes = [10][]byte{
+		bits("1110010"), bits("1100110"), bits("1101100"), bits("1000010"), bits("1011100"),
+		bits("1001110"), bits("1010000"), bits("1000100"), bits("1001000"), bits("1110100"),
+	}
+)
+
+// parityPatterns gives, for each possible first digit of an EAN-13 symbol,
+// the sequence of L/G parity used to encode the following six digits. This
+// is how the first digit is encoded without a module pattern of its own.
+var parityPatterns = [10]string{
+	"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG",
+	"LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL",
+}
+
+// addOnParityPatterns gives, for each possible checksum of a 5-digit EAN-5
+// supplement, the sequence of L/G parity used to encode its five digits.
+var addOnParityPatterns = [10]string{
+	"GGLLL", "GLGLL", "GLLGL", "GLLLG", "LGGLL",
+	"LLGGL", "LLLGG", "LGLGL", "LGLLG", "LLGLG",
+}
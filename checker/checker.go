@@ -0,0 +1,58 @@
+// Package checker registers "isbn", "isbn10" and "isbn13" struct tag
+// checkers with github.com/cinar/checker, backed by the isbn package's
+// checksum validation, so fields can be annotated like:
+//
+//	Field string `checkers:"isbn13"`
+//
+// Importing this package for its side effect is enough; it registers its
+// checkers in checker's package-level registry on init, overriding the
+// upstream "isbn" checker (which does not understand hyphens, URNs or SBNs)
+// with one backed by the isbn package.
+package checker
+
+import (
+	"reflect"
+
+	"github.com/cinar/checker"
+
+	"github.com/skowalak/isbn"
+)
+
+func init() {
+	checker.Register("isbn", makeISBN)
+	checker.Register("isbn10", makeISBN10)
+	checker.Register("isbn13", makeISBN13)
+}
+
+func makeISBN(_ string) checker.CheckFunc {
+	return checkISBN
+}
+
+func makeISBN10(_ string) checker.CheckFunc {
+	return checkISBN10
+}
+
+func makeISBN13(_ string) checker.CheckFunc {
+	return checkISBN13
+}
+
+func checkISBN(value, _ reflect.Value) checker.Result {
+	if isbn.IsValid(value.String()) {
+		return checker.ResultValid
+	}
+	return checker.ResultNotISBN
+}
+
+func checkISBN10(value, _ reflect.Value) checker.Result {
+	if isbn.IsISBN10(value.String()) {
+		return checker.ResultValid
+	}
+	return checker.ResultNotISBN
+}
+
+func checkISBN13(value, _ reflect.Value) checker.Result {
+	if isbn.IsISBN13(value.String()) {
+		return checker.ResultValid
+	}
+	return checker.ResultNotISBN
+}
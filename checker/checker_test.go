@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"testing"
+
+	cinar_checker "github.com/cinar/checker"
+
+	_ "github.com/skowalak/isbn/checker"
+)
+
+type book struct {
+	ISBN13 string `checkers:"isbn13"`
+	ISBN10 string `checkers:"isbn10"`
+	Any    string `checkers:"isbn"`
+}
+
+func TestRegister(t *testing.T) {
+	valid := book{
+		ISBN13: "9780672323560",
+		ISBN10: "0672323567",
+		Any:    "0672323567",
+	}
+	if _, ok := cinar_checker.Check(valid); !ok {
+		t.Error("got invalid for a valid book")
+	}
+
+	invalid := book{
+		ISBN13: "not-an-isbn",
+		ISBN10: "9780672323560",
+		Any:    "not-an-isbn",
+	}
+	if _, ok := cinar_checker.Check(invalid); ok {
+		t.Error("got valid for an invalid book")
+	}
+}
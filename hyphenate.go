@@ -0,0 +1,117 @@
+package isbn
+
+import "fmt"
+
+// Hyphenate splits a valid ISBN-10, ISBN-13 or SBN into its ISBN-13 GS1
+// prefix, registration group, registrant and publication elements and joins
+// them with hyphens, e.g. "0672323567" becomes "978-0-672-32356-0". The
+// split is looked up in the range tables baked in from the International
+// ISBN Agency's RangeMessage; if the prefix or registration group is not
+// covered by those tables, ErrRangeNotCovered is returned.
+func Hyphenate(s string) (string, error) {
+	p, err := parse(s)
+	if err != nil {
+		return "", err
+	}
+	return hyphenate(p)
+}
+
+func hyphenate(p parsed) (string, error) {
+	group, registrant, publication, err := hyphenateParts(p)
+	if err != nil {
+		return "", err
+	}
+	gs1 := convertDigitsToString(p.body[:3])
+	return fmt.Sprintf("%s-%s-%s-%s-%s", gs1, group, registrant, publication, checkDigit(p)), nil
+}
+
+// checkDigit returns the ISBN-13 check digit of p as its printable digit
+// character.
+func checkDigit(p parsed) string {
+	return convertDigitsToString([]int32{check13(p.body)})
+}
+
+// check10Digit returns the ISBN-10/SBN check digit of p as its printable
+// character, rendering the value 10 as "X" per the mod-11 algorithm.
+func check10Digit(p parsed) string {
+	d := check10(p.body[3:])
+	if d == 10 {
+		return "X"
+	}
+	return convertDigitsToString([]int32{d})
+}
+
+// hyphenateParts splits the 9 digits following an ISBN's GS1 prefix into its
+// registration group, registrant and publication elements using the baked
+// range tables.
+func hyphenateParts(p parsed) (group, registrant, publication string, err error) {
+	gs1 := convertDigitsToString(p.body[:3])
+	remaining := p.body[3:12]
+
+	groupLen, err := lookupRange(gs1Ranges[gs1], digitsToRangeValue(remaining))
+	if err != nil {
+		return "", "", "", fmt.Errorf("isbn: hyphenate: registration group for prefix %s: %w", gs1, err)
+	}
+	groupDigits, rest := remaining[:groupLen], remaining[groupLen:]
+
+	groupKey := gs1 + "-" + convertDigitsToString(groupDigits)
+	registrantLen, err := lookupRange(groupRanges[groupKey], digitsToRangeValue(rest))
+	if err != nil {
+		return "", "", "", fmt.Errorf("isbn: hyphenate: registrant for group %s: %w", groupKey, err)
+	}
+	registrantDigits, publicationDigits := rest[:registrantLen], rest[registrantLen:]
+
+	return convertDigitsToString(groupDigits), convertDigitsToString(registrantDigits), convertDigitsToString(publicationDigits), nil
+}
+
+// ISBN13Hyphenated takes a valid ISBN-13 or ISBN-10 and returns the
+// corresponding ISBN-13, hyphenated into its GS1, group, registrant and
+// publication elements.
+func ISBN13Hyphenated(s string) (string, error) {
+	p, err := parse(s)
+	if err != nil {
+		return "", err
+	}
+	return hyphenate(p)
+}
+
+// ISBN10Hyphenated takes a valid ISBN-13 or ISBN-10 and returns the
+// corresponding ISBN-10, hyphenated into its group, registrant and
+// publication elements.
+func ISBN10Hyphenated(s string) (string, error) {
+	p, err := parse(s)
+	if err != nil {
+		return "", err
+	}
+	if string(p.body[:3]) != isbn10Prefix13 {
+		// cannot convert ISBN-13 to ISBN-10 because only ISBNs with 978 prefix
+		// can be interpreted as ISBN-10
+		return "", fmt.Errorf("isbn: isbn-10: gs1 is not 978")
+	}
+	group, registrant, publication, err := hyphenateParts(p)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s-%s-%s", group, registrant, publication, check10Digit(p)), nil
+}
+
+// SBNHyphenated takes a valid ISBN-13 or ISBN-10 and returns the
+// corresponding SBN, hyphenated into its registrant and publication
+// elements. An SBN only exists if the ISBN group element is zero.
+func SBNHyphenated(s string) (string, error) {
+	p, err := parse(s)
+	if err != nil {
+		return "", err
+	}
+	if string(p.body[3:4]) != "\x00" {
+		// cannot interpret ISBN as SBN because SBN depends on having the same
+		// checksum as the equivalent ISBN-10 - which is only possible if the
+		// ISBN group part is '0'
+		return "", fmt.Errorf("isbn: sbn: group is not 0")
+	}
+	_, registrant, publication, err := hyphenateParts(p)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s-%s", registrant, publication, check10Digit(p)), nil
+}
@@ -0,0 +1,67 @@
+package isbn
+
+import "testing"
+
+func TestHyphenate(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		valid bool
+	}{
+		{
+			input: "0672323567",
+			want:  "978-0-672-32356-0",
+			valid: true,
+		},
+		{
+			input: "1-316-87371-4",
+			want:  "978-1-316-87371-7",
+			valid: true,
+		},
+		{
+			// registration group 4 is not covered by the baked range tables
+			input: "9784444444446",
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		got, err := Hyphenate(tt.input)
+		if tt.valid {
+			if err != nil {
+				t.Errorf("got error for valid isbn %v: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v want %v", got, tt.want)
+			}
+		} else if err == nil {
+			t.Errorf("got no error for uncovered isbn %v", tt.input)
+		}
+	}
+}
+
+func TestISBN10Hyphenated(t *testing.T) {
+	got, err := ISBN10Hyphenated("9780672323560")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "0-672-32356-7"
+	if got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestSBNHyphenated(t *testing.T) {
+	got, err := SBNHyphenated("340013818")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "340-01381-8"; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	// SBN requires the ISBN group element to be zero
+	_, err = SBNHyphenated("9781316873717")
+	if err == nil {
+		t.Errorf("got no error for isbn with non-zero group")
+	}
+}
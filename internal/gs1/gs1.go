@@ -0,0 +1,84 @@
+// Package gs1 implements the digit sanitation and check digit algorithm
+// shared by every GS1-13 product code: ISBN-13, ISMN and the EAN-13 form of
+// ISSN all use the same weighted mod-10 checksum, differing only in which
+// GS1 prefixes they allow.
+package gs1
+
+import (
+	"fmt"
+	"strings"
+)
+
+const integerRuneStart = 0x30
+
+// SanitizeRune is a map function to be used with strings.Map that strips all
+// characters other than digits and the check character 'X' from a string and
+// returns int32 values of digits, with 'X' (or 'x') mapped to 10.
+func SanitizeRune(r rune) rune {
+	if r >= '0' && r <= '9' {
+		// return the integer value of the rune by subtracting the number of
+		// utf-8 runes before the first integer rune
+		return r - integerRuneStart
+	}
+	if r == 'X' || r == 'x' {
+		// in base11 checksums 'X' is used to substitute 10.
+		return 10
+	}
+	return -1
+}
+
+// ConvertDigitsToString reverts SanitizeRune by converting int32 values to
+// their utf-8 representation as string.
+func ConvertDigitsToString(i []int32) (s string) {
+	s = strings.Map(func(r rune) rune {
+		return r + integerRuneStart
+	}, string(i))
+	return s
+}
+
+// Check13 calculates the GS1-13 check digit by multiplying every digit with
+// a weight, adding them together so that the sum of all digits including the
+// check is a multiple of 10.
+// If 13 digits are passed in the input slice, the last digit will be
+// discarded in favour of the new check digit.
+func Check13(i []int32) int32 {
+	if len(i) == 13 {
+		i = i[:12]
+	}
+	var check int32
+	for index, number := range i {
+		if index%2 == 0 {
+			check = check + number
+			continue
+		}
+		check = check + number*3
+	}
+	return (10 - check%10) % 10
+}
+
+// Parse13 parses a string of 13 sanitized digits, verifying that it begins
+// with one of the given GS1 prefixes (given as plain digit strings, e.g.
+// "978" or "9790") and that its check digit is valid.
+func Parse13(s string, prefixes ...string) ([]int32, error) {
+	runes := []rune(s)
+	if len(runes) != 13 {
+		return nil, fmt.Errorf("gs1: invalid length %d", len(runes))
+	}
+	digits := []int32(runes)
+
+	ok := false
+	for _, prefix := range prefixes {
+		if len(prefix) <= len(digits) && ConvertDigitsToString(digits[:len(prefix)]) == prefix {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("gs1: prefix not in %v", prefixes)
+	}
+
+	if Check13(digits) != digits[12] {
+		return nil, fmt.Errorf("gs1: invalid checksum")
+	}
+	return digits, nil
+}
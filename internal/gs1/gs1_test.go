@@ -0,0 +1,49 @@
+package gs1
+
+import "testing"
+
+func TestCheck13(t *testing.T) {
+	// example from wikipedia
+	data := []int32{9, 7, 8, 0, 3, 0, 6, 4, 0, 6, 1, 5}
+	var want int32 = 7
+
+	got := Check13(data)
+	if got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestParse13(t *testing.T) {
+	tests := []struct {
+		input    string
+		prefixes []string
+		valid    bool
+	}{
+		{
+			input:    "\x09\x07\x08\x00\x03\x00\x06\x04\x00\x06\x01\x05\x07",
+			prefixes: []string{"978", "979"},
+			valid:    true,
+		},
+		{
+			// wrong check digit
+			input:    "\x09\x07\x08\x00\x03\x00\x06\x04\x00\x06\x01\x05\x00",
+			prefixes: []string{"978", "979"},
+			valid:    false,
+		},
+		{
+			// prefix not allowed
+			input:    "\x09\x07\x07\x00\x03\x00\x06\x04\x00\x06\x01\x05\x07",
+			prefixes: []string{"978", "979"},
+			valid:    false,
+		},
+	}
+	for _, tt := range tests {
+		_, err := Parse13(tt.input, tt.prefixes...)
+		if tt.valid && err != nil {
+			t.Errorf("got error for valid input: %v", err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("got no error for invalid input %q", tt.input)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Command gen reads a RangeMessage.xml document from the International
+// ISBN Agency and bakes it into a Go source file containing the range
+// tables used by Hyphenate, so lookups never have to touch the filesystem
+// at runtime.
+//
+// It is invoked via go:generate from the isbn package:
+//
+//	//go:generate go run ./internal/rangemessage/gen -input rangemessage.xml -output ranges_gen.go -package isbn
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+
+	"github.com/skowalak/isbn/internal/rangemessage"
+)
+
+func main() {
+	input := flag.String("input", "rangemessage.xml", "path to RangeMessage.xml")
+	output := flag.String("output", "ranges_gen.go", "path to write the generated table to")
+	pkg := flag.String("package", "isbn", "package name for the generated file")
+	flag.Parse()
+
+	if err := run(*input, *output, *pkg); err != nil {
+		fmt.Fprintln(os.Stderr, "gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(input, output, pkg string) error {
+	f, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reg, err := rangemessage.Parse(f)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by go generate from %s; DO NOT EDIT.\n\n", input)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+
+	fmt.Fprint(&buf, "var gs1Ranges = map[string][]rangeRule{\n")
+	writeTable(&buf, reg.EANUCC)
+	fmt.Fprint(&buf, "}\n\n")
+
+	fmt.Fprint(&buf, "var groupRanges = map[string][]rangeRule{\n")
+	writeTable(&buf, reg.Groups)
+	fmt.Fprint(&buf, "}\n")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("gen: formatting generated source: %w", err)
+	}
+
+	return os.WriteFile(output, formatted, 0o644)
+}
+
+func writeTable(buf *bytes.Buffer, table map[string][]rangemessage.Rule) {
+	keys := make([]string, 0, len(table))
+	for k := range table {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Fprintf(buf, "\t%q: {\n", key)
+		for _, rule := range table[key] {
+			fmt.Fprintf(buf, "\t\t{lower: %d, upper: %d, length: %d},\n", rule.Lower, rule.Upper, rule.Length)
+		}
+		fmt.Fprint(buf, "\t},\n")
+	}
+}
@@ -0,0 +1,153 @@
+// Package rangemessage parses the RangeMessage.xml published by the
+// International ISBN Agency, which describes how the digits following an
+// ISBN's GS1 prefix are carved up into registration group, registrant and
+// publication elements.
+//
+// The XML schema is the one the agency has shipped for years: an
+// EAN.UCCPrefixes section giving the length of the registration group for
+// ranges of the 978/979 remainder, and a RegistrationGroups section giving
+// the length of the registrant for ranges within each registration group.
+package rangemessage
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Rule describes one contiguous range of a 7-digit remainder for which a
+// fixed element length applies. Lower and Upper are inclusive.
+type Rule struct {
+	Lower, Upper int64
+	Length       int
+}
+
+// Registry is the parsed, queryable form of a RangeMessage.xml document.
+type Registry struct {
+	// EANUCC maps a GS1 prefix ("978", "979") to the rules that determine
+	// the length of the registration group.
+	EANUCC map[string][]Rule
+	// Groups maps a "prefix-group" key (e.g. "978-0") to the rules that
+	// determine the length of the registrant.
+	Groups map[string][]Rule
+}
+
+type xmlMessage struct {
+	EANUCCPrefixes struct {
+		EANUCC []xmlEANUCC `xml:"EAN.UCC"`
+	} `xml:"EAN.UCCPrefixes"`
+	RegistrationGroups struct {
+		Group []xmlGroup `xml:"Group"`
+	} `xml:"RegistrationGroups"`
+}
+
+type xmlEANUCC struct {
+	Prefix string    `xml:"Prefix"`
+	Rules  []xmlRule `xml:"Rules>Rule"`
+}
+
+type xmlGroup struct {
+	Prefix string    `xml:"Prefix"`
+	Agency string    `xml:"Agency"`
+	Rules  []xmlRule `xml:"Rules>Rule"`
+}
+
+type xmlRule struct {
+	Range  string `xml:"Range"`
+	Length int    `xml:"Length"`
+}
+
+// Parse reads a RangeMessage.xml document and returns the Registry built
+// from it.
+func Parse(r io.Reader) (*Registry, error) {
+	var msg xmlMessage
+	if err := xml.NewDecoder(r).Decode(&msg); err != nil {
+		return nil, fmt.Errorf("rangemessage: decode: %w", err)
+	}
+
+	reg := &Registry{
+		EANUCC: make(map[string][]Rule, len(msg.EANUCCPrefixes.EANUCC)),
+		Groups: make(map[string][]Rule, len(msg.RegistrationGroups.Group)),
+	}
+
+	for _, p := range msg.EANUCCPrefixes.EANUCC {
+		rules, err := convertRules(p.Rules)
+		if err != nil {
+			return nil, fmt.Errorf("rangemessage: prefix %q: %w", p.Prefix, err)
+		}
+		reg.EANUCC[p.Prefix] = rules
+	}
+
+	for _, g := range msg.RegistrationGroups.Group {
+		rules, err := convertRules(g.Rules)
+		if err != nil {
+			return nil, fmt.Errorf("rangemessage: group %q: %w", g.Prefix, err)
+		}
+		reg.Groups[g.Prefix] = rules
+	}
+
+	return reg, nil
+}
+
+func convertRules(in []xmlRule) ([]Rule, error) {
+	out := make([]Rule, 0, len(in))
+	for _, r := range in {
+		if r.Length == 0 {
+			// a length of zero marks the range as unassigned
+			continue
+		}
+		lower, upper, err := parseRange(r.Range)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, Rule{Lower: lower, Upper: upper, Length: r.Length})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Lower < out[j].Lower })
+	return out, nil
+}
+
+func parseRange(s string) (lower, upper int64, err error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("rangemessage: malformed range %q", s)
+	}
+	lower, err = strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("rangemessage: malformed range %q: %w", s, err)
+	}
+	upper, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("rangemessage: malformed range %q: %w", s, err)
+	}
+	return lower, upper, nil
+}
+
+// ErrNotCovered is returned by the Length lookups when no rule in the
+// registry covers the requested remainder. New registration groups and
+// registrants are assigned periodically, so this is expected to happen for
+// ranges reserved after the registry was generated.
+var ErrNotCovered = fmt.Errorf("rangemessage: remainder not covered by any rule")
+
+// GroupLength returns the length of the registration group for the given
+// GS1 prefix ("978" or "979") and 7-digit remainder.
+func (reg *Registry) GroupLength(prefix string, remainder int64) (int, error) {
+	return lookup(reg.EANUCC[prefix], remainder)
+}
+
+// RegistrantLength returns the length of the registrant for the given
+// "prefix-group" key (e.g. "978-0") and remainder following the
+// registration group.
+func (reg *Registry) RegistrantLength(prefixGroup string, remainder int64) (int, error) {
+	return lookup(reg.Groups[prefixGroup], remainder)
+}
+
+func lookup(rules []Rule, remainder int64) (int, error) {
+	i := sort.Search(len(rules), func(i int) bool { return rules[i].Upper >= remainder })
+	if i < len(rules) && rules[i].Lower <= remainder && remainder <= rules[i].Upper {
+		return rules[i].Length, nil
+	}
+	return 0, ErrNotCovered
+}
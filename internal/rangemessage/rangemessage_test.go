@@ -0,0 +1,69 @@
+package rangemessage
+
+import (
+	"strings"
+	"testing"
+)
+
+const sample = `<?xml version="1.0" encoding="UTF-8"?>
+<ISBNRangeMessage>
+  <EAN.UCCPrefixes>
+    <EAN.UCC>
+      <Prefix>978</Prefix>
+      <Rules>
+        <Rule><Range>0000000-5999999</Range><Length>1</Length></Rule>
+        <Rule><Range>6000000-6999999</Range><Length>2</Length></Rule>
+      </Rules>
+    </EAN.UCC>
+  </EAN.UCCPrefixes>
+  <RegistrationGroups>
+    <Group>
+      <Prefix>978-0</Prefix>
+      <Agency>English language</Agency>
+      <Rules>
+        <Rule><Range>0000000-1999999</Range><Length>2</Length></Rule>
+        <Rule><Range>2000000-2279999</Range><Length>0</Length></Rule>
+      </Rules>
+    </Group>
+  </RegistrationGroups>
+</ISBNRangeMessage>`
+
+func TestParse(t *testing.T) {
+	reg, err := Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	length, err := reg.GroupLength("978", 672323)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if length != 1 {
+		t.Errorf("got %v want %v", length, 1)
+	}
+
+	length, err = reg.RegistrantLength("978-0", 672323)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if length != 2 {
+		t.Errorf("got %v want %v", length, 2)
+	}
+
+	// a length of zero in the source document marks the range unassigned,
+	// so it must not show up as a rule in the registry
+	if _, err := reg.RegistrantLength("978-0", 2100000); err != ErrNotCovered {
+		t.Errorf("got %v want %v", err, ErrNotCovered)
+	}
+
+	if _, err := reg.GroupLength("977", 0); err != ErrNotCovered {
+		t.Errorf("got %v want %v", err, ErrNotCovered)
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	_, err := Parse(strings.NewReader(`<ISBNRangeMessage><EAN.UCCPrefixes><EAN.UCC><Prefix>978</Prefix><Rules><Rule><Range>bad</Range><Length>1</Length></Rule></Rules></EAN.UCC></EAN.UCCPrefixes></ISBNRangeMessage>`))
+	if err == nil {
+		t.Error("got no error for malformed range")
+	}
+}
@@ -5,39 +5,27 @@ package isbn
 import (
 	"fmt"
 	"strings"
+
+	"github.com/skowalak/isbn/internal/gs1"
 )
 
 type parsed struct {
 	body []int32
+	kind Kind
 }
 
-const integerRuneStart = 0x30
 const urnPrefix string = "urn:isbn:"
 const isbn10Prefix13 string = "\x09\x07\x08"
 
-// sanitizeRune is a map function to be used with strings.Map that strips all
-// non-ISBN characters from a string and returns int32 values of digits
+// sanitizeRune strips all non-ISBN characters from a string, see
+// gs1.SanitizeRune.
 func sanitizeRune(r rune) rune {
-	if r >= '0' && r <= '9' {
-		// return the integer value of the rune by subtracting the number of utf-8
-		// runes before the first integer rune
-		return r - integerRuneStart
-	}
-	if r == 'X' || r == 'x' {
-		// in base11 checksums used for SBNs and ISB-10s 'X' is used to substitute
-		// 10.
-		return 10
-	}
-	return -1
+	return gs1.SanitizeRune(r)
 }
 
-// convertDigitsToString reverts sanitizeRune by converting int32 values to
-// their utf-8 representation as string
-func convertDigitsToString(i []int32) (s string) {
-	s = strings.Map(func(r rune) rune {
-		return r + integerRuneStart
-	}, string(i))
-	return s
+// convertDigitsToString reverts sanitizeRune, see gs1.ConvertDigitsToString.
+func convertDigitsToString(i []int32) string {
+	return gs1.ConvertDigitsToString(i)
 }
 
 // parse parses a string to an isbn
@@ -67,7 +55,12 @@ func parse(s string) (parsed, error) {
 
 // parseSbn parses a slice of 9 integers by interpreting them as ISBN-10
 func parseSbn(s string) (parsed, error) {
-	return parse10("\x00" + s)
+	p, err := parse10("\x00" + s)
+	if err != nil {
+		return parsed{}, err
+	}
+	p.kind = KindSBN
+	return p, nil
 }
 
 // parse10 parses a slice of 10 integers by calculating the check digit.
@@ -78,45 +71,36 @@ func parse10(s string) (parsed, error) {
 	}
 	return parsed{
 		body: append([]int32{9, 7, 8}, runes...),
+		kind: KindISBN10,
 	}, nil
 }
 
 // parse13 parses a slice of 13 integers by verifying they begin with a valid
-// isbn prefix (978 or 979) and calculating the check digit.
+// isbn prefix (978 or 979) and calculating the check digit. The prefix check
+// is the ISBN product kind's own restriction; ISMN and ISSN parse the same
+// kind of digit string through gs1.Parse13 with a different set of allowed
+// prefixes.
 //
 // currently this function does only verify if an isbn adheres to the isbn
 // format, but not if it is actually allocated by the international isbn agency
 func parse13(s string) (parsed, error) {
-	runes := []rune(s)
-	if string(runes[:3]) != "\x09\x07\x08" && string(runes[:3]) != "\x09\x07\x09" {
-		return parsed{}, fmt.Errorf("isbn: invalid isbn-13 gs1")
+	digits, err := gs1.Parse13(s, "978", "979")
+	if err != nil {
+		return parsed{}, fmt.Errorf("isbn: invalid isbn-13: %w", err)
 	}
-	if check13(runes) != runes[len(runes)-1] {
-		return parsed{}, fmt.Errorf("isbn: invalid isbn-13 checksum")
+	kind := KindISBN13_978
+	if gs1.ConvertDigitsToString(digits[:3]) == "979" {
+		kind = KindISBN13_979
 	}
 	return parsed{
-		body: runes,
+		body: digits,
+		kind: kind,
 	}, nil
 }
 
-// check13 calculates the check digit for an ISBN-13 by multiplying every digit
-// with a weight, adding them together so that the sum of all digits including
-// the check is a multiple of 10.
-// If 13 digits are passed in the input slice, the last digit will be discarded
-// in favour of the new check digit.
+// check13 calculates the GS1-13 check digit, see gs1.Check13.
 func check13(i []int32) int32 {
-	if len(i) == 13 {
-		i = i[:12]
-	}
-	var check int32
-	for index, number := range i {
-		if index%2 == 0 {
-			check = check + number
-			continue
-		}
-		check = check + number*3
-	}
-	return (10 - check%10) % 10
+	return gs1.Check13(i)
 }
 
 // check10 calculates the check digit for an ISBN-10 by multiplying every digit
@@ -140,14 +124,14 @@ func isbn13(p parsed) string {
 }
 
 func isbn10(p parsed) string {
-	body := convertDigitsToString(p.body[3:])
-	check := rune(check13(p.body))
-	return fmt.Sprintf("%s%c", body, check)
+	body := convertDigitsToString(p.body[3:12])
+	return body + check10Digit(p)
 }
 
 // SBN takes a valid ISBN-13 or ISBN-10 and returns the corresponding British
-// Standard Book Number (SBN) which is nine digits and two hyphens long. An SBN
-// only exists, if the ISBN group element is zero.
+// Standard Book Number (SBN) which is nine digits long. An SBN only exists,
+// if the ISBN group element is zero. For a hyphenated SBN, use
+// SBNHyphenated.
 func SBN(s string) (string, error) {
 	p, err := parse(s)
 	if err != nil {
@@ -159,13 +143,13 @@ func SBN(s string) (string, error) {
 		// group part is '0'
 		return "", fmt.Errorf("isbn: sbn: group is not 0")
 	}
-	body := convertDigitsToString(p.body[4:])
-	check := rune(check13(p.body))
-	return fmt.Sprintf("%s%c", body, check), nil
+	body := convertDigitsToString(p.body[4:12])
+	return body + check10Digit(p), nil
 }
 
 // ISBN10 takes a valid ISBN-13 or ISBN-10 and returns the corresponding
-// ISBN-10 which is ten runes and three hyphens long.
+// ISBN-10 which is ten digits long. For a hyphenated ISBN-10, use
+// ISBN10Hyphenated.
 func ISBN10(s string) (string, error) {
 	p, err := parse(s)
 	if err != nil {
@@ -180,7 +164,8 @@ func ISBN10(s string) (string, error) {
 }
 
 // ISBN13 takes a valid ISBN-13 or ISBN-10 and returns the corresponding
-// ISBN-13 which is thirteen runes and four hyphens long.
+// ISBN-13 which is thirteen digits long. For a hyphenated ISBN-13, use
+// ISBN13Hyphenated.
 func ISBN13(s string) (string, error) {
 	p, err := parse(s)
 	if err != nil {
@@ -95,6 +95,32 @@ func TestIsbn10Parser(t *testing.T) {
 		}
 	}
 }
+func TestISBN10(t *testing.T) {
+	got, err := ISBN10("9780306406157")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "0306406152"; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestSBN(t *testing.T) {
+	got, err := SBN("9780340013816")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "340013818"; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	// SBN requires the ISBN group element to be zero
+	_, err = SBN("9781316873717")
+	if err == nil {
+		t.Errorf("got no error for isbn with non-zero group")
+	}
+}
+
 func TestIsbn13Parser(t *testing.T) {
 	// for anyone reading this, i just pulled the newest books from nyt
 	// bestsellers
@@ -0,0 +1,86 @@
+package isbn
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skowalak/isbn/internal/gs1"
+)
+
+const ismnURNPrefix string = "urn:ismn:"
+const issnURNPrefix string = "urn:issn:"
+
+// ISMN parses a 13-digit International Standard Music Number - a GS1-13
+// product code sharing ISBN-13's check digit algorithm, always under the
+// 979-0 prefix - and returns its canonical 13-digit form.
+//
+// currently this function only accepts the modern 13-digit EAN form; the
+// legacy "M-" prefixed 10-character ISMN format is not supported.
+func ISMN(s string) (string, error) {
+	s = strings.TrimPrefix(s, ismnURNPrefix)
+	runes := strings.Map(gs1.SanitizeRune, s)
+
+	digits, err := gs1.Parse13(runes, "9790")
+	if err != nil {
+		return "", fmt.Errorf("ismn: %w", err)
+	}
+	return gs1.ConvertDigitsToString(digits), nil
+}
+
+// ISSN parses an International Standard Serial Number, either as the
+// standalone 8-digit form (7 digits plus a mod-11 check digit, which may be
+// 'X') or as the 977-prefixed ISSN-13 it is embedded in on periodicals'
+// covers, and returns the canonical 13-digit EAN form.
+func ISSN(s string) (string, error) {
+	s = strings.TrimPrefix(s, issnURNPrefix)
+	runes := strings.Map(gs1.SanitizeRune, s)
+
+	switch len(runes) {
+	case 8:
+		return parseIssn8(runes)
+	case 13:
+		return parseIssn13(runes)
+	}
+	return "", fmt.Errorf("issn: parse: invalid length %d", len(runes))
+}
+
+// parseIssn8 parses a slice of 8 integers by calculating the standalone
+// ISSN's own mod-11 check digit, then converts it to the 13-digit EAN form
+// under the 977 prefix with the issue/variant code defaulted to "00".
+func parseIssn8(s string) (string, error) {
+	runes := []rune(s)
+	if checkIssn(runes) != runes[len(runes)-1] {
+		return "", fmt.Errorf("issn: invalid issn-8 checksum")
+	}
+
+	ean := append([]int32{9, 7, 7}, runes[:7]...)
+	ean = append(ean, 0, 0)
+	ean = append(ean, gs1.Check13(ean))
+	return gs1.ConvertDigitsToString(ean), nil
+}
+
+// parseIssn13 parses a slice of 13 integers by verifying it begins with the
+// 977 prefix ISSN is embedded under, and calculating the GS1-13 check digit.
+func parseIssn13(s string) (string, error) {
+	digits, err := gs1.Parse13(s, "977")
+	if err != nil {
+		return "", fmt.Errorf("issn: %w", err)
+	}
+	return gs1.ConvertDigitsToString(digits), nil
+}
+
+// checkIssn calculates the check digit for a standalone ISSN by multiplying
+// its 7 digits with descending weights 8 down to 2 and adding them together
+// so that the sum of all digits including the check is a multiple of eleven.
+// A check value of 10 is represented as the digit value 10, which
+// gs1.SanitizeRune also maps 'X' to.
+func checkIssn(i []int32) int32 {
+	if len(i) == 8 {
+		i = i[:7]
+	}
+	var check int32
+	for index, number := range i {
+		check += number * int32(8-index)
+	}
+	return (11 - check%11) % 11
+}
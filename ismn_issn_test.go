@@ -0,0 +1,102 @@
+package isbn
+
+import "testing"
+
+func TestISMN(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		valid bool
+	}{
+		{
+			input: "9790260000438",
+			want:  "9790260000438",
+			valid: true,
+		},
+		{
+			input: "979-0-2600-0043-8",
+			want:  "9790260000438",
+			valid: true,
+		},
+		{
+			input: "urn:ismn:9790260000438",
+			want:  "9790260000438",
+			valid: true,
+		},
+		{
+			// 978 is an ISBN prefix, not an ISMN one
+			input: "9780260000439",
+			valid: false,
+		},
+		{
+			// wrong checksum
+			input: "9790260000439",
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		got, err := ISMN(tt.input)
+		if tt.valid {
+			if err != nil {
+				t.Errorf("got error for valid ismn %v: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v want %v", got, tt.want)
+			}
+		} else if err == nil {
+			t.Errorf("got no error for invalid ismn %v", tt.input)
+		}
+	}
+}
+
+func TestISSN(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		valid bool
+	}{
+		{
+			input: "0317-8471",
+			want:  "9770317847001",
+			valid: true,
+		},
+		{
+			input: "03178471",
+			want:  "9770317847001",
+			valid: true,
+		},
+		{
+			input: "9770317847001",
+			want:  "9770317847001",
+			valid: true,
+		},
+		{
+			// wrong standalone checksum
+			input: "03178472",
+			valid: false,
+		},
+		{
+			// wrong EAN checksum
+			input: "9770317847002",
+			valid: false,
+		},
+		{
+			// 978 is not the ISSN prefix
+			input: "9780317847001",
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		got, err := ISSN(tt.input)
+		if tt.valid {
+			if err != nil {
+				t.Errorf("got error for valid issn %v: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v want %v", got, tt.want)
+			}
+		} else if err == nil {
+			t.Errorf("got no error for invalid issn %v", tt.input)
+		}
+	}
+}
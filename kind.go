@@ -0,0 +1,42 @@
+package isbn
+
+// Kind identifies which ISBN-like format a parsed string represents.
+type Kind int
+
+const (
+	// KindSBN marks a nine-digit British Standard Book Number.
+	KindSBN Kind = iota
+	// KindISBN10 marks a ten-digit ISBN-10.
+	KindISBN10
+	// KindISBN13_978 marks a thirteen-digit ISBN-13 with the 978 GS1 prefix.
+	KindISBN13_978
+	// KindISBN13_979 marks a thirteen-digit ISBN-13 with the 979 GS1 prefix.
+	KindISBN13_979
+)
+
+// String returns a human-readable name for k.
+func (k Kind) String() string {
+	switch k {
+	case KindSBN:
+		return "SBN"
+	case KindISBN10:
+		return "ISBN-10"
+	case KindISBN13_978:
+		return "ISBN-13 (978)"
+	case KindISBN13_979:
+		return "ISBN-13 (979)"
+	default:
+		return "unknown"
+	}
+}
+
+// KindOf parses s and reports which ISBN-like format it is. It is named
+// KindOf rather than Kind because Go does not allow a function and a type
+// to share an identifier in the same package.
+func KindOf(s string) (Kind, error) {
+	p, err := parse(s)
+	if err != nil {
+		return 0, err
+	}
+	return p.kind, nil
+}
@@ -0,0 +1,56 @@
+package isbn
+
+import "fmt"
+
+//go:generate go run ./internal/rangemessage/gen -input rangemessage.xml -output ranges_gen.go -package isbn
+
+// rangeRule describes one contiguous range of a 7-digit, right-padded
+// remainder for which a fixed element length applies. Lower and Upper are
+// inclusive. The tables built from these rules are baked in by go generate
+// from the International ISBN Agency's RangeMessage.xml; see ranges_gen.go.
+type rangeRule struct {
+	lower, upper int64
+	length       int
+}
+
+// ErrRangeNotCovered is returned by Hyphenate when the baked range tables do
+// not cover the registration group or registrant of a given ISBN. New
+// registration groups and registrants are assigned by the International
+// ISBN Agency periodically, so the tables need to be regenerated (via `go
+// generate`) from time to time to stay current.
+var ErrRangeNotCovered = fmt.Errorf("isbn: range not covered by the ISBN range tables")
+
+// digitsToRangeValue normalizes a slice of digits to the 7-digit value the
+// range tables are keyed by, truncating longer inputs and right-padding
+// shorter ones with zeroes.
+func digitsToRangeValue(digits []int32) int64 {
+	if len(digits) > 7 {
+		digits = digits[:7]
+	}
+	var v int64
+	for _, d := range digits {
+		v = v*10 + int64(d)
+	}
+	for i := len(digits); i < 7; i++ {
+		v *= 10
+	}
+	return v
+}
+
+// lookupRange finds the length of the range covering value in rules, which
+// must be sorted by lower bound. It runs in O(log n).
+func lookupRange(rules []rangeRule, value int64) (int, error) {
+	lo, hi := 0, len(rules)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if rules[mid].upper < value {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(rules) && rules[lo].lower <= value && value <= rules[lo].upper {
+		return rules[lo].length, nil
+	}
+	return 0, ErrRangeNotCovered
+}
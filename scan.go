@@ -0,0 +1,235 @@
+package isbn
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Match is a single ISBN found in free text by Scanner or FindAll.
+type Match struct {
+	// Offset is the byte offset of Raw's first byte in the scanned text.
+	Offset int64
+	// Raw is the original, unmodified substring that was matched, including
+	// any hyphens, spaces or line breaks it was printed with.
+	Raw string
+	// ISBN13 is Raw normalized to its unhyphenated ISBN-13 form.
+	ISBN13 string
+	// Kind identifies which ISBN-like format Raw was in.
+	Kind Kind
+}
+
+// maxCandidateDigits is the longest digit run Scanner considers: no valid
+// ISBN has more than 13 digits, so a candidate is closed as soon as it
+// reaches this length instead of growing further. This lets a valid ISBN be
+// recognized even when it is immediately followed - with only whitespace or
+// other separators in between - by more digits, such as a second ISBN in a
+// whitespace-delimited list.
+const maxCandidateDigits = 13
+
+// isSeparator reports whether r may appear between the digits of an ISBN in
+// free text: spaces, hyphens, en-dashes, and the line breaks of
+// line-wrapped, hyphenated text.
+func isSeparator(r rune) bool {
+	switch r {
+	case ' ', '\t', '-', '–', '\n', '\r':
+		return true
+	}
+	return false
+}
+
+// Scanner finds every ISBN-10, ISBN-13 and SBN in a stream of text,
+// tolerating the spacing, dashes, "ISBN:" labels and line-wrapping that
+// real-world bibliographic text is full of, while still verifying the real
+// checksum of every candidate. Construct one with Scan.
+type Scanner struct {
+	r   *bufio.Reader
+	err error
+
+	match  Match
+	offset int64
+
+	// digits is reused across candidates; it never grows past
+	// maxCandidateDigits, so Scanner never reallocates it.
+	digits []int32
+	raw    []byte
+}
+
+// Scan returns a Scanner that finds ISBNs in r.
+func Scan(r io.Reader) *Scanner {
+	return &Scanner{
+		r:      bufio.NewReader(r),
+		digits: make([]int32, 0, maxCandidateDigits),
+	}
+}
+
+// Scan advances the Scanner to the next match, which is then available
+// through Match. It returns false once the text is exhausted or a read
+// error occurs, which can then be retrieved with Err.
+func (s *Scanner) Scan() bool {
+	digits := s.digits[:0]
+	raw := s.raw[:0]
+	overflow := false
+	sawSeparator := false // a separator was seen since the last digit
+	var start int64
+	rawEnd := 0 // length of raw up to and including its last digit
+
+	emit := func() bool {
+		defer func() {
+			s.digits = digits[:0]
+			s.raw = raw[:0]
+		}()
+		if overflow || len(digits) == 0 {
+			return false
+		}
+		p, kind, ok := parseDigits(digits)
+		if !ok {
+			return false
+		}
+		s.match = Match{Offset: start, Raw: string(raw[:rawEnd]), ISBN13: normalizeISBN13(p), Kind: kind}
+		return true
+	}
+
+	for {
+		r, size, err := s.r.ReadRune()
+		if err != nil {
+			if err != io.EOF {
+				s.err = err
+				return false
+			}
+			return emit()
+		}
+
+		// 'X'/'x' only ever appears as an ISBN's trailing check digit, so it
+		// must not start a candidate on its own - otherwise ordinary words
+		// ending in "x" (like "box" or "prefix") would be mistaken for the
+		// start of one.
+		isCheckX := r == 'X' || r == 'x'
+		if d := sanitizeRune(r); d >= 0 && !(isCheckX && len(digits) == 0) {
+			if sawSeparator && closesBeforeExtending(digits) {
+				// A separator appeared since the last digit, and the
+				// digits collected so far either already form a complete,
+				// checksum-valid SBN/ISBN-10 or have reached the longest
+				// length any ISBN has - so r begins a new candidate rather
+				// than extending this one. This is what lets e.g. a
+				// newline- or tab-separated list of ISBN-10s in a catalog
+				// dump be found one by one instead of merging into a
+				// single, invalid run. If the digits held here were
+				// already known-overflowed (part of one unbroken run
+				// longer than maxCandidateDigits), they were never a real
+				// candidate and must be discarded, not emitted.
+				if err := s.r.UnreadRune(); err != nil {
+					s.err = err
+					return false
+				}
+				if !overflow && emit() {
+					return true
+				}
+				digits, raw, overflow, sawSeparator, rawEnd = digits[:0], raw[:0], false, false, 0
+				continue
+			}
+			if len(digits) == maxCandidateDigits {
+				// No separator since the last digit: r continues one
+				// unbroken run of more digits than any valid ISBN has,
+				// e.g. part of a longer account number. It can never
+				// validate as a whole, so stop growing digits but keep
+				// consuming until a terminator, where the whole run is
+				// discarded.
+				overflow = true
+				raw = append(raw, string(r)...)
+				s.offset += int64(size)
+				continue
+			}
+			if len(digits) == 0 {
+				start = s.offset
+			}
+			digits = append(digits, d)
+			raw = append(raw, string(r)...)
+			rawEnd = len(raw)
+			sawSeparator = false
+			s.offset += int64(size)
+			continue
+		}
+
+		if isSeparator(r) && len(digits) > 0 {
+			raw = append(raw, string(r)...)
+			sawSeparator = true
+			s.offset += int64(size)
+			continue
+		}
+
+		// r terminates any candidate currently in progress.
+		s.offset += int64(size)
+		if emit() {
+			return true
+		}
+		digits, raw, overflow, sawSeparator, rawEnd = digits[:0], raw[:0], false, false, 0
+	}
+}
+
+// Match returns the most recent match found by Scan.
+func (s *Scanner) Match() Match {
+	return s.match
+}
+
+// Err returns the first non-EOF error encountered while reading, if any.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// normalizeISBN13 renders p's ISBN-13 prefix, group, registrant and
+// publication digits followed by a freshly computed check digit, the same
+// way checkDigit does for Hyphenate.
+func normalizeISBN13(p parsed) string {
+	return convertDigitsToString(p.body[:12]) + checkDigit(p)
+}
+
+// closesBeforeExtending reports whether a candidate holding digits must be
+// closed before another digit can be appended to it: either it has reached
+// maxCandidateDigits, the longest length any ISBN has, or it already
+// validates as a complete SBN or ISBN-10. A 9- or 10-digit run that does not
+// yet validate is left to keep growing, since it may still be a prefix of a
+// longer, hyphenated ISBN-13 whose registration group happens to end there.
+func closesBeforeExtending(digits []int32) bool {
+	if len(digits) == maxCandidateDigits {
+		return true
+	}
+	if len(digits) != 9 && len(digits) != 10 {
+		return false
+	}
+	_, _, ok := parseDigits(digits)
+	return ok
+}
+
+// parseDigits validates a candidate run of 9, 10 or 13 digit values (as
+// produced by sanitizeRune) as an SBN, ISBN-10 or ISBN-13 respectively.
+func parseDigits(digits []int32) (parsed, Kind, bool) {
+	var p parsed
+	var err error
+
+	switch len(digits) {
+	case 9:
+		p, err = parseSbn(string(digits))
+	case 10:
+		p, err = parse10(string(digits))
+	case 13:
+		p, err = parse13(string(digits))
+	default:
+		return parsed{}, 0, false
+	}
+	if err != nil {
+		return parsed{}, 0, false
+	}
+	return p, p.kind, true
+}
+
+// FindAll returns every ISBN-10, ISBN-13 and SBN found in s, in order of
+// appearance.
+func FindAll(s string) []Match {
+	sc := Scan(strings.NewReader(s))
+	var matches []Match
+	for sc.Scan() {
+		matches = append(matches, sc.Match())
+	}
+	return matches
+}
@@ -0,0 +1,146 @@
+package isbn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindAll(t *testing.T) {
+	text := "See ISBN: 978-0-672-32356-0 and also 340013818 (SBN), " +
+		"but not this account number 123456789012345678 or this date 2024-01-01.\n" +
+		"A line-wrapped one: 978-1-\n316-87371-7 should still be found."
+
+	want := []struct {
+		raw    string
+		isbn13 string
+		kind   Kind
+	}{
+		{"978-0-672-32356-0", "9780672323560", KindISBN13_978},
+		{"340013818", "9780340013816", KindSBN},
+		{"978-1-\n316-87371-7", "9781316873717", KindISBN13_978},
+	}
+
+	matches := FindAll(text)
+	if len(matches) != len(want) {
+		t.Fatalf("got %d matches, want %d: %+v", len(matches), len(want), matches)
+	}
+	for i, m := range matches {
+		if m.Raw != want[i].raw {
+			t.Errorf("match %d: got Raw %q want %q", i, m.Raw, want[i].raw)
+		}
+		if m.ISBN13 != want[i].isbn13 {
+			t.Errorf("match %d: got ISBN13 %v want %v", i, m.ISBN13, want[i].isbn13)
+		}
+		if m.Kind != want[i].kind {
+			t.Errorf("match %d: got Kind %v want %v", i, m.Kind, want[i].kind)
+		}
+		if text[m.Offset:int(m.Offset)+len(m.Raw)] != m.Raw {
+			t.Errorf("match %d: Raw %q not found at Offset %d", i, m.Raw, m.Offset)
+		}
+	}
+}
+
+func TestScannerNoMatches(t *testing.T) {
+	sc := Scan(strings.NewReader("nothing to see here, just 123456789012345678 and 42"))
+	if sc.Scan() {
+		t.Fatalf("got unexpected match %+v", sc.Match())
+	}
+	if err := sc.Err(); err != nil {
+		t.Errorf("got error %v", err)
+	}
+}
+
+// A valid-looking 13-digit ISBN embedded in one longer, unbroken digit run
+// (no whitespace or hyphen anywhere in it) is not a real ISBN and must not
+// match, even though its leading 13 digits alone would pass the checksum.
+func TestScannerNoMatchForContinuousOverlongRun(t *testing.T) {
+	sc := Scan(strings.NewReader("ref 97813168737175551 end"))
+	if sc.Scan() {
+		t.Fatalf("got unexpected match %+v", sc.Match())
+	}
+	if err := sc.Err(); err != nil {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestFindAllSeparatedList(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "space-separated ISBN-13s",
+			text: "9780672323560 9781316873717",
+			want: []string{"9780672323560", "9781316873717"},
+		},
+		{
+			name: "space-separated ISBN-10s",
+			text: "0306406152 0306406152",
+			want: []string{"9780306406157", "9780306406157"},
+		},
+		{
+			name: "space-separated ISBN-10 and ISBN-13",
+			text: "0306406152 9780306406157",
+			want: []string{"9780306406157", "9780306406157"},
+		},
+		{
+			name: "tab-separated ISBN-10s",
+			text: "0306406152\t0306406152",
+			want: []string{"9780306406157", "9780306406157"},
+		},
+		{
+			name: "newline-separated ISBN-10s, one per line",
+			text: "0306406152\n0306406152\n0306406152",
+			want: []string{"9780306406157", "9780306406157", "9780306406157"},
+		},
+		{
+			name: "space-separated SBNs",
+			text: "340013818 340013818",
+			want: []string{"9780340013816", "9780340013816"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := FindAll(tt.text)
+			if len(matches) != len(tt.want) {
+				t.Fatalf("got %d matches, want %d: %+v", len(matches), len(tt.want), matches)
+			}
+			for i, m := range matches {
+				if m.ISBN13 != tt.want[i] {
+					t.Errorf("match %d: got ISBN13 %v want %v", i, m.ISBN13, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// A valid ISBN following an over-long, separator-free digit run must still
+// be found - the discarded run must not poison the next candidate.
+func TestFindAllAfterOverlongRun(t *testing.T) {
+	matches := FindAll("junk 1234567890123456 9780672323560 more")
+	if len(matches) != 1 {
+		t.Fatalf("got %d matches, want 1: %+v", len(matches), matches)
+	}
+	if want := "9780672323560"; matches[0].ISBN13 != want {
+		t.Errorf("got %v want %v", matches[0].ISBN13, want)
+	}
+}
+
+func TestScannerOffsets(t *testing.T) {
+	text := "prefix 340013818 suffix"
+	sc := Scan(strings.NewReader(text))
+	if !sc.Scan() {
+		t.Fatalf("expected a match, got none: %v", sc.Err())
+	}
+	m := sc.Match()
+	if want := int64(strings.Index(text, "340013818")); m.Offset != want {
+		t.Errorf("got offset %v want %v", m.Offset, want)
+	}
+	if m.Raw != "340013818" {
+		t.Errorf("got raw %q want %q", m.Raw, "340013818")
+	}
+	if sc.Scan() {
+		t.Errorf("got unexpected second match %+v", sc.Match())
+	}
+}
@@ -0,0 +1,27 @@
+package isbn
+
+// Validate checks whether s is a syntactically and checksum-valid ISBN-10,
+// ISBN-13 or SBN, returning the parse error if not.
+func Validate(s string) error {
+	_, err := parse(s)
+	return err
+}
+
+// IsValid reports whether s is a valid ISBN-10, ISBN-13 or SBN.
+func IsValid(s string) bool {
+	return Validate(s) == nil
+}
+
+// IsISBN10 reports whether s is a valid ISBN-10 or SBN, which can both be
+// converted to an ISBN-10 string.
+func IsISBN10(s string) bool {
+	k, err := KindOf(s)
+	return err == nil && (k == KindISBN10 || k == KindSBN)
+}
+
+// IsISBN13 reports whether s is a valid ISBN-13, of either the 978 or 979
+// GS1 prefix.
+func IsISBN13(s string) bool {
+	k, err := KindOf(s)
+	return err == nil && (k == KindISBN13_978 || k == KindISBN13_979)
+}
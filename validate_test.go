@@ -0,0 +1,71 @@
+package isbn
+
+import "testing"
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Kind
+		valid bool
+	}{
+		{input: "0672323567", want: KindISBN10, valid: true},
+		{input: "059610183X", want: KindISBN10, valid: true},
+		{input: "9780672323560", want: KindISBN13_978, valid: true},
+		{input: "067232356", want: 0, valid: false}, // too short to be anything
+	}
+	for _, tt := range tests {
+		got, err := KindOf(tt.input)
+		if tt.valid {
+			if err != nil {
+				t.Errorf("got error for valid input %v: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v want %v", got, tt.want)
+			}
+		} else if err == nil {
+			t.Errorf("got no error for invalid input %v", tt.input)
+		}
+	}
+}
+
+func TestKindOfSBN(t *testing.T) {
+	// a nine-digit British SBN, equivalent to ISBN-10 0-340-01381-8 with the
+	// group element implied to be zero
+	got, err := KindOf("340013818")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != KindSBN {
+		t.Errorf("got %v want %v", got, KindSBN)
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	if !IsValid("0672323567") {
+		t.Error("got false for a valid isbn")
+	}
+	if IsValid("0672323568") {
+		t.Error("got true for an invalid isbn")
+	}
+}
+
+func TestIsISBN10(t *testing.T) {
+	if !IsISBN10("0672323567") {
+		t.Error("got false for a valid isbn-10")
+	}
+	if !IsISBN10("340013818") {
+		t.Error("got false for a valid sbn")
+	}
+	if IsISBN10("9780672323560") {
+		t.Error("got true for an isbn-13")
+	}
+}
+
+func TestIsISBN13(t *testing.T) {
+	if !IsISBN13("9780672323560") {
+		t.Error("got false for a valid isbn-13")
+	}
+	if IsISBN13("0672323567") {
+		t.Error("got true for an isbn-10")
+	}
+}
@@ -0,0 +1,39 @@
+// Package validator registers "isbn", "isbn10" and "isbn13" struct tags with
+// github.com/go-playground/validator/v10, backed by the isbn package's
+// checksum validation, so fields can be annotated like:
+//
+//	Field string `validate:"isbn13"`
+package validator
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"github.com/skowalak/isbn"
+)
+
+// Register registers the "isbn", "isbn10" and "isbn13" validators on v.
+func Register(v *validator.Validate) error {
+	validations := map[string]validator.Func{
+		"isbn":   validateISBN,
+		"isbn10": validateISBN10,
+		"isbn13": validateISBN13,
+	}
+	for tag, fn := range validations {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateISBN(fl validator.FieldLevel) bool {
+	return isbn.IsValid(fl.Field().String())
+}
+
+func validateISBN10(fl validator.FieldLevel) bool {
+	return isbn.IsISBN10(fl.Field().String())
+}
+
+func validateISBN13(fl validator.FieldLevel) bool {
+	return isbn.IsISBN13(fl.Field().String())
+}
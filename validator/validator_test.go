@@ -0,0 +1,40 @@
+package validator_test
+
+import (
+	"testing"
+
+	go_playground_validator "github.com/go-playground/validator/v10"
+
+	"github.com/skowalak/isbn/validator"
+)
+
+type book struct {
+	ISBN13 string `validate:"isbn13"`
+	ISBN10 string `validate:"isbn10"`
+	Any    string `validate:"isbn"`
+}
+
+func TestRegister(t *testing.T) {
+	v := go_playground_validator.New()
+	if err := validator.Register(v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	valid := book{
+		ISBN13: "9780672323560",
+		ISBN10: "0672323567",
+		Any:    "0672323567",
+	}
+	if err := v.Struct(valid); err != nil {
+		t.Errorf("got error for a valid book: %v", err)
+	}
+
+	invalid := book{
+		ISBN13: "not-an-isbn",
+		ISBN10: "9780672323560",
+		Any:    "not-an-isbn",
+	}
+	if err := v.Struct(invalid); err == nil {
+		t.Error("got no error for an invalid book")
+	}
+}